@@ -0,0 +1,67 @@
+// Copyright Contributors to the Open Cluster Management project
+
+package policystatus
+
+import (
+	"fmt"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	appsv1 "open-cluster-management.io/multicloud-operators-subscription/pkg/apis/apps/placementrule/v1"
+
+	policiesv1 "open-cluster-management.io/governance-policy-propagator/api/v1"
+)
+
+// BenchmarkCalculatePerClusterStatus constructs a fake client with one replicated policy per
+// cluster to measure the effect of MaxStatusLookupWorkers at the scale described in the propagator
+// scale gist (1000+ clusters).
+func BenchmarkCalculatePerClusterStatus(b *testing.B) {
+	const numClusters = 1000
+
+	scheme := runtime.NewScheme()
+	if err := policiesv1.AddToScheme(scheme); err != nil {
+		b.Fatalf("failed to build scheme: %v", err)
+	}
+
+	rootPolicy := &policiesv1.Policy{}
+	rootPolicy.Namespace = "policies"
+	rootPolicy.Name = "root"
+
+	decisions := make(decisionSet, numClusters)
+	objs := make([]client.Object, 0, numClusters)
+
+	for i := 0; i < numClusters; i++ {
+		clusterName := fmt.Sprintf("cluster-%d", i)
+
+		decisions[appsv1.PlacementDecision{ClusterName: clusterName, ClusterNamespace: clusterName}] = true
+
+		replicatedPolicy := &policiesv1.Policy{}
+		replicatedPolicy.Namespace = clusterName
+		replicatedPolicy.Name = rootPolicy.Namespace + "." + rootPolicy.Name
+		replicatedPolicy.Status.ComplianceState = policiesv1.Compliant
+
+		objs = append(objs, replicatedPolicy)
+	}
+
+	for _, workers := range []int{1, defaultMaxStatusLookupWorkers} {
+		workers := workers
+
+		b.Run(fmt.Sprintf("workers=%d", workers), func(b *testing.B) {
+			reconciler := &RootPolicyStatusReconciler{
+				Client:                 fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build(),
+				MaxStatusLookupWorkers: workers,
+			}
+
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				if _, err := reconciler.calculatePerClusterStatus(rootPolicy, decisions); err != nil {
+					b.Fatalf("unexpected error: %v", err)
+				}
+			}
+		})
+	}
+}
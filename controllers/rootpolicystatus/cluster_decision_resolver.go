@@ -0,0 +1,88 @@
+// Copyright Contributors to the Open Cluster Management project
+
+package policystatus
+
+import (
+	"context"
+	"fmt"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	clusterv1beta1 "open-cluster-management.io/api/cluster/v1beta1"
+	appsv1 "open-cluster-management.io/multicloud-operators-subscription/pkg/apis/apps/placementrule/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	policiesv1 "open-cluster-management.io/governance-policy-propagator/api/v1"
+	"open-cluster-management.io/governance-policy-propagator/controllers/common"
+)
+
+// ClusterDecisionResolver resolves the managed clusters that a PlacementBinding's PlacementRef
+// selects for a policy. Built-in resolvers are registered for the "PlacementRule" and "Placement"
+// kinds; downstream integrators can register resolvers for additional placement backends (e.g. a
+// Karmada-style PropagationPolicy, or a custom scheduling CRD) via RootPolicyStatusReconciler's
+// RegisterResolver, turning the propagator into an extensible propagation engine rather than one
+// tied to the two built-in placement APIs.
+type ClusterDecisionResolver interface {
+	// Resolve returns the cluster decisions selected by the PlacementBinding's PlacementRef for
+	// policy, along with a Placement describing the ref (its Kind-specific name field populated,
+	// empty if the ref doesn't exist yet). When policy.Spec.Disabled is true, implementations
+	// should still return the Placement but skip computing decisions, matching the built-in
+	// resolvers' behavior of not putting a disabled policy on any cluster.
+	Resolve(
+		ctx context.Context, pb *policiesv1.PlacementBinding, policy *policiesv1.Policy,
+	) (decisions []appsv1.PlacementDecision, refPlacement *policiesv1.Placement, err error)
+}
+
+// placementRuleResolver is the built-in ClusterDecisionResolver for PlacementRef.Kind
+// "PlacementRule".
+type placementRuleResolver struct {
+	client.Client
+}
+
+func (res *placementRuleResolver) Resolve(
+	ctx context.Context, pb *policiesv1.PlacementBinding, policy *policiesv1.Policy,
+) ([]appsv1.PlacementDecision, *policiesv1.Placement, error) {
+	refNN := types.NamespacedName{Namespace: pb.GetNamespace(), Name: pb.PlacementRef.Name}
+
+	plr := &appsv1.PlacementRule{}
+	if err := res.Get(ctx, refNN, plr); err != nil && !k8serrors.IsNotFound(err) {
+		return nil, nil, fmt.Errorf("failed to check for PlacementRule '%v': %w", pb.PlacementRef.Name, err)
+	}
+
+	refPlacement := &policiesv1.Placement{PlacementRule: plr.Name} // will be empty if not found
+
+	if policy.Spec.Disabled {
+		return nil, refPlacement, nil
+	}
+
+	decisions, err := common.GetDecisions(res.Client, pb)
+
+	return decisions, refPlacement, err
+}
+
+// placementDecisionResolver is the built-in ClusterDecisionResolver for PlacementRef.Kind
+// "Placement".
+type placementDecisionResolver struct {
+	client.Client
+}
+
+func (res *placementDecisionResolver) Resolve(
+	ctx context.Context, pb *policiesv1.PlacementBinding, policy *policiesv1.Policy,
+) ([]appsv1.PlacementDecision, *policiesv1.Placement, error) {
+	refNN := types.NamespacedName{Namespace: pb.GetNamespace(), Name: pb.PlacementRef.Name}
+
+	pl := &clusterv1beta1.Placement{}
+	if err := res.Get(ctx, refNN, pl); err != nil && !k8serrors.IsNotFound(err) {
+		return nil, nil, fmt.Errorf("failed to check for Placement '%v': %w", pb.PlacementRef.Name, err)
+	}
+
+	refPlacement := &policiesv1.Placement{Placement: pl.Name} // will be empty if not found
+
+	if policy.Spec.Disabled {
+		return nil, refPlacement, nil
+	}
+
+	decisions, err := common.GetDecisions(res.Client, pb)
+
+	return decisions, refPlacement, err
+}
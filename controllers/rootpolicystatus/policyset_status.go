@@ -0,0 +1,251 @@
+// Copyright Contributors to the Open Cluster Management project
+
+package policystatus
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	policiesv1 "open-cluster-management.io/governance-policy-propagator/api/v1"
+	policiesv1beta1 "open-cluster-management.io/governance-policy-propagator/api/v1beta1"
+)
+
+// policySetLockKey is a distinct type (rather than a bare types.NamespacedName) for keying
+// RootPolicyLocks entries that guard a PolicySet, not a Policy. Reusing types.NamespacedName
+// directly would deadlock a reconcile if a Policy and a PolicySet it belongs to ever share a
+// namespace+name, since sync.Mutex is not reentrant and the caller already holds the root policy's
+// lock under that same key.
+type policySetLockKey types.NamespacedName
+
+// mapPolicySetToRootPolicies reverse-maps a PolicySet change to reconcile requests for every root
+// policy listed in its membership, so that membership changes (not just Policy or PlacementBinding
+// changes) trigger a recomputation of the PolicySet's aggregate status. The caller filters this
+// watch to generation changes only, so that this reconciler's own Status().Update calls on the
+// PolicySet don't re-trigger the very members that just caused them.
+func mapPolicySetToRootPolicies(_ client.Client) handler.MapFunc {
+	return func(_ context.Context, obj client.Object) []reconcile.Request {
+		policySet, ok := obj.(*policiesv1beta1.PolicySet)
+		if !ok {
+			return nil
+		}
+
+		requests := make([]reconcile.Request, 0, len(policySet.Spec.Policies))
+
+		for _, memberName := range policySet.Spec.Policies {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Namespace: policySet.GetNamespace(),
+					Name:      string(memberName),
+				},
+			})
+		}
+
+		return requests
+	}
+}
+
+// updateReferencedPolicySets recomputes and writes back the aggregate status of every PolicySet
+// that the root policy is bound to via a PlacementBinding. It is called after the root policy's
+// own status has been persisted, so that member policy lookups see the latest compliance state.
+// Failures are logged and skipped per-PolicySet so that one bad PolicySet doesn't block the others.
+//
+// NOTE: this assumes no other controller in the cluster (e.g. a separate PolicySet status
+// controller) also writes policiesv1beta1.PolicySet.Status. If one exists, it will race with these
+// Status().Update calls.
+func (r *RootPolicyStatusReconciler) updateReferencedPolicySets(
+	rootPolicy *policiesv1.Policy, placements []*policiesv1.Placement,
+) {
+	policySetPlacements := make(map[string][]*policiesv1.Placement)
+
+	for _, placement := range placements {
+		if placement.PolicySet != "" {
+			policySetPlacements[placement.PolicySet] = append(policySetPlacements[placement.PolicySet], placement)
+		}
+	}
+
+	for name, setPlacements := range policySetPlacements {
+		if err := r.updatePolicySetStatus(rootPolicy.Namespace, name, setPlacements); err != nil {
+			log.Error(err, "Failed to update the PolicySet aggregate status",
+				"policySetNamespace", rootPolicy.Namespace, "policySetName", name)
+		}
+	}
+}
+
+// updatePolicySetStatus recomputes a single PolicySet's aggregate status: the worst-case
+// ComplianceState rollup across constituent policies **per cluster** (Status.Compliant holds the
+// worst cluster-level result overall), a human-readable summary naming the members contributing a
+// NonCompliant or Pending cluster (Status.StatusMessage), and the placements that bind the
+// PolicySet (Status.Placement). A member that hasn't reported any per-cluster status yet (not yet
+// created, or still Pending its first replication) is treated as not-yet-contributing rather than
+// forced to Pending, so adding a new member doesn't spuriously flip an otherwise-compliant
+// PolicySet. Writes are serialized through RootPolicyLocks, shared with the root policy status
+// updates, to avoid conflicting writes - but under a distinct key from the Policy lock, so that a
+// PolicySet sharing a namespace+name with one of its own member policies can't self-deadlock the
+// reconcile that already holds the Policy's lock.
+func (r *RootPolicyStatusReconciler) updatePolicySetStatus(
+	namespace, name string, setPlacements []*policiesv1.Placement,
+) error {
+	nn := types.NamespacedName{Namespace: namespace, Name: name}
+
+	lock, _ := r.RootPolicyLocks.LoadOrStore(policySetLockKey(nn), &sync.Mutex{})
+
+	lock.(*sync.Mutex).Lock()
+	defer lock.(*sync.Mutex).Unlock()
+
+	policySet := &policiesv1beta1.PolicySet{}
+
+	if err := r.Get(context.TODO(), nn, policySet); err != nil {
+		return err
+	}
+
+	clusterStates := make(map[string]policiesv1.ComplianceState)
+
+	var nonCompliant, pending []string
+
+	for _, memberName := range policySet.Spec.Policies {
+		member := &policiesv1.Policy{}
+		memberNN := types.NamespacedName{Namespace: namespace, Name: string(memberName)}
+
+		if err := r.Get(context.TODO(), memberNN, member); err != nil {
+			log.Error(err, "Failed to get a PolicySet member policy", "policyName", memberName)
+
+			continue
+		}
+
+		memberNonCompliant, memberPending := false, false
+
+		for _, cpcs := range member.Status.Status {
+			if cpcs.ComplianceState == "" {
+				// This member hasn't computed a status for this cluster yet; it doesn't contribute
+				// to the rollup until it does.
+				continue
+			}
+
+			if existing, ok := clusterStates[cpcs.ClusterName]; ok {
+				clusterStates[cpcs.ClusterName] = worstComplianceState(existing, cpcs.ComplianceState)
+			} else {
+				clusterStates[cpcs.ClusterName] = cpcs.ComplianceState
+			}
+
+			switch cpcs.ComplianceState {
+			case policiesv1.NonCompliant:
+				memberNonCompliant = true
+			case policiesv1.Pending:
+				memberPending = true
+			}
+		}
+
+		if memberNonCompliant {
+			nonCompliant = append(nonCompliant, string(memberName))
+		} else if memberPending {
+			pending = append(pending, string(memberName))
+		}
+	}
+
+	sort.Strings(nonCompliant)
+	sort.Strings(pending)
+
+	var overall policiesv1.ComplianceState
+
+	for _, state := range clusterStates {
+		if overall == "" {
+			overall = state
+
+			continue
+		}
+
+		overall = worstComplianceState(overall, state)
+	}
+
+	if overall == "" {
+		// No member has reported a per-cluster status yet.
+		overall = policiesv1.Pending
+	}
+
+	placementSummary := make([]policiesv1beta1.PolicySetStatusPlacement, 0, len(setPlacements))
+
+	for _, placement := range setPlacements {
+		placementSummary = append(placementSummary, policiesv1beta1.PolicySetStatusPlacement{
+			PlacementBinding: placement.PlacementBinding,
+			Placement:        placement.Placement,
+		})
+	}
+
+	sort.Slice(placementSummary, func(i, j int) bool {
+		return placementSummary[i].PlacementBinding < placementSummary[j].PlacementBinding
+	})
+
+	policySet.Status.Placement = placementSummary
+	policySet.Status.Compliant = string(overall)
+	policySet.Status.StatusMessage = policySetStatusMessage(overall, nonCompliant, pending)
+
+	return r.Status().Update(context.TODO(), policySet)
+}
+
+// policySetStatusMessage builds a short human-readable summary of the PolicySet's aggregate
+// compliance, naming which member policies are contributing a NonCompliant or Pending cluster.
+func policySetStatusMessage(overall policiesv1.ComplianceState, nonCompliant, pending []string) string {
+	if len(nonCompliant) == 0 && len(pending) == 0 {
+		if overall == policiesv1.Pending {
+			return "no member policies have reported a compliance status yet"
+		}
+
+		return fmt.Sprintf("%s: all member policies are compliant", overall)
+	}
+
+	details := make([]string, 0, 2)
+
+	if len(nonCompliant) > 0 {
+		details = append(details, fmt.Sprintf("non-compliant: %s", strings.Join(nonCompliant, ", ")))
+	}
+
+	if len(pending) > 0 {
+		details = append(details, fmt.Sprintf("pending: %s", strings.Join(pending, ", ")))
+	}
+
+	return fmt.Sprintf("%s: %s", overall, strings.Join(details, "; "))
+}
+
+// worstComplianceState returns the more severe of two ComplianceStates, using the precedence
+// NonCompliant > Pending > Compliant. An empty/unreported state is normalized to Pending before
+// comparing, so the result is always one of the three defined states and never an empty string.
+// Callers accumulating over a sequence should seed with the first real value rather than an empty
+// string, since normalize would otherwise bias an empty accumulator towards Pending.
+func worstComplianceState(a, b policiesv1.ComplianceState) policiesv1.ComplianceState {
+	normalize := func(s policiesv1.ComplianceState) policiesv1.ComplianceState {
+		if s == "" {
+			return policiesv1.Pending
+		}
+
+		return s
+	}
+
+	a, b = normalize(a), normalize(b)
+
+	rank := func(s policiesv1.ComplianceState) int {
+		switch s {
+		case policiesv1.NonCompliant:
+			return 3
+		case policiesv1.Pending:
+			return 2
+		case policiesv1.Compliant:
+			return 0
+		default:
+			return 1
+		}
+	}
+
+	if rank(a) >= rank(b) {
+		return a
+	}
+
+	return b
+}
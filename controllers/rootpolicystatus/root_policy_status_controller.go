@@ -9,18 +9,28 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"time"
 
+	"golang.org/x/sync/errgroup"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	clusterv1beta1 "open-cluster-management.io/api/cluster/v1beta1"
 	appsv1 "open-cluster-management.io/multicloud-operators-subscription/pkg/apis/apps/placementrule/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	policiesv1 "open-cluster-management.io/governance-policy-propagator/api/v1"
 	policiesv1beta1 "open-cluster-management.io/governance-policy-propagator/api/v1beta1"
@@ -30,6 +40,15 @@ import (
 
 const ControllerName string = "root-policy-status"
 
+// rootPolicyLabel is set by the propagator on every replicated policy, pointing back at its root
+// policy. Root policies never carry this label, so it doubles as a selector for "root policies
+// only" list calls.
+const rootPolicyLabel string = "policy.open-cluster-management.io/root-policy"
+
+// defaultMaxStatusLookupWorkers is used when MaxStatusLookupWorkers is unset, bounding how many
+// replicated policy lookups calculatePerClusterStatus issues concurrently.
+const defaultMaxStatusLookupWorkers int = 16
+
 var log = ctrl.Log.WithName(ControllerName)
 
 //+kubebuilder:rbac:groups=policy.open-cluster-management.io,resources=policies,verbs=get;list;watch
@@ -37,7 +56,10 @@ var log = ctrl.Log.WithName(ControllerName)
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *RootPolicyStatusReconciler) SetupWithManager(mgr ctrl.Manager, maxConcurrentReconciles uint) error {
-	return ctrl.NewControllerManagedBy(mgr).
+	r.RegisterResolver("PlacementRule", &placementRuleResolver{Client: mgr.GetClient()})
+	r.RegisterResolver("Placement", &placementDecisionResolver{Client: mgr.GetClient()})
+
+	bldr := ctrl.NewControllerManagedBy(mgr).
 		WithOptions(controller.Options{MaxConcurrentReconciles: int(maxConcurrentReconciles)}).
 		Named(ControllerName).
 		For(
@@ -64,7 +86,59 @@ func (r *RootPolicyStatusReconciler) SetupWithManager(mgr ctrl.Manager, maxConcu
 			handler.EnqueueRequestsFromMapFunc(common.MapToRootPolicy(mgr.GetClient())),
 			builder.WithPredicates(policyStatusPredicate()),
 		).
-		Complete(r)
+		// A PolicySet's membership can change independently of any Policy or PlacementBinding, so
+		// reverse-map it to its member root policies to recompute their PolicySet aggregate status.
+		// GenerationChangedPredicate filters out this reconciler's own Status().Update calls on the
+		// PolicySet, which would otherwise re-trigger every member on every status-only write.
+		Watches(
+			&policiesv1beta1.PolicySet{},
+			handler.EnqueueRequestsFromMapFunc(mapPolicySetToRootPolicies(mgr.GetClient())),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		)
+
+	if r.DriftResyncInterval > 0 {
+		driftEvents := make(chan event.GenericEvent)
+
+		bldr = bldr.WatchesRawSource(&source.Channel{Source: driftEvents}, &handler.EnqueueRequestForObject{})
+
+		if err := mgr.Add(&driftDetector{
+			r:           r,
+			interval:    r.DriftResyncInterval,
+			driftEvents: driftEvents,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return bldr.Complete(r)
+}
+
+// driftDetector is a manager Runnable that periodically recomputes the status of every root
+// policy and enqueues a reconcile for any whose persisted status has drifted from what is
+// currently computed. It only runs on the leader, so that multiple replicas of the controller
+// don't duplicate the scan.
+type driftDetector struct {
+	r           *RootPolicyStatusReconciler
+	interval    time.Duration
+	driftEvents chan<- event.GenericEvent
+}
+
+func (d *driftDetector) NeedLeaderElection() bool {
+	return true
+}
+
+func (d *driftDetector) Start(ctx context.Context) error {
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			d.r.detectDrift(ctx, d.driftEvents)
+		}
+	}
 }
 
 // blank assignment to verify that RootPolicyStatusReconciler implements reconcile.Reconciler
@@ -76,6 +150,67 @@ type RootPolicyStatusReconciler struct {
 	// Use a shared lock with the main policy controller to avoid conflicting updates.
 	RootPolicyLocks *sync.Map
 	Scheme          *runtime.Scheme
+	// Recorder is used to emit compliance-transition events on the root Policy.
+	Recorder record.EventRecorder
+	// StatusUpdateBatchWindow debounces root policy status updates so that a burst of replicated
+	// policy status changes for the same root policy (e.g. hundreds of clusters flipping
+	// compliance at once) results in a single Status().Update, rather than one per incoming change.
+	// Its compliance-transition Events (one per cluster whose state changed) are computed against
+	// the status from before the burst. A zero value disables batching, and
+	// every reconcile updates the status immediately, as before.
+	StatusUpdateBatchWindow time.Duration
+	// DriftResyncInterval, when set, enables a periodic background scan (run only on the leader)
+	// that recomputes every root policy's status and enqueues a reconcile for any whose persisted
+	// status has drifted from what is currently computed. This catches the case where a watch
+	// event for a replicated policy status change was dropped or missed. A zero value disables the
+	// scan.
+	DriftResyncInterval time.Duration
+	// DisableTransitionEvents turns off the per-cluster compliance-transition Events emitted on the
+	// root Policy. Set this for large environments where the extra event volume isn't wanted.
+	DisableTransitionEvents bool
+	// MaxStatusLookupWorkers bounds how many replicated policy lookups calculatePerClusterStatus
+	// issues concurrently. A zero or negative value falls back to defaultMaxStatusLookupWorkers.
+	MaxStatusLookupWorkers int
+	// batches tracks in-flight coalescing state, keyed by the root policy's NamespacedName.
+	batches sync.Map
+	// resolvers maps a PlacementRef.Kind to the ClusterDecisionResolver used to resolve it.
+	// Populated with the built-in PlacementRule/Placement resolvers in SetupWithManager; downstream
+	// integrators can register additional backends via RegisterResolver.
+	resolvers   map[string]ClusterDecisionResolver
+	resolversMu sync.RWMutex
+}
+
+// RegisterResolver registers a ClusterDecisionResolver to resolve PlacementBindings whose
+// PlacementRef.Kind matches kind. This is how downstream integrators (e.g. a Karmada-style
+// PropagationPolicy, or a custom scheduling CRD) plug in their own placement backend without
+// changing the core reconcile loop. Call this during manager setup, before the controller starts
+// processing requests; registering the same kind again replaces the previous resolver.
+func (r *RootPolicyStatusReconciler) RegisterResolver(kind string, resolver ClusterDecisionResolver) {
+	r.resolversMu.Lock()
+	defer r.resolversMu.Unlock()
+
+	if r.resolvers == nil {
+		r.resolvers = make(map[string]ClusterDecisionResolver)
+	}
+
+	r.resolvers[kind] = resolver
+}
+
+func (r *RootPolicyStatusReconciler) resolverFor(kind string) (ClusterDecisionResolver, bool) {
+	r.resolversMu.RLock()
+	defer r.resolversMu.RUnlock()
+
+	resolver, ok := r.resolvers[kind]
+
+	return resolver, ok
+}
+
+// statusUpdateBatch tracks the state of a debounced status update for a single root policy. The
+// baselineCPCS is captured from the first reconcile in the burst, so that the eventual update can
+// summarize every cluster-level compliance transition that occurred during the window instead of
+// just the last one.
+type statusUpdateBatch struct {
+	baselineCPCS []*policiesv1.CompliancePerClusterStatus
 }
 
 // Reconcile will update the root policy status based on the current state whenever a root or replicated policy status
@@ -108,9 +243,17 @@ func (r *RootPolicyStatusReconciler) Reconcile(ctx context.Context, request ctrl
 		return reconcile.Result{}, err
 	}
 
+	if r.StatusUpdateBatchWindow > 0 {
+		log.V(2).Info("Scheduling a batched root policy status update")
+
+		r.scheduleStatusUpdate(rootPolicy)
+
+		return reconcile.Result{}, nil
+	}
+
 	log.Info("Updating the root policy status")
 
-	err = r.rootStatusUpdate(rootPolicy) //nolint:contextcheck
+	err = r.rootStatusUpdate(rootPolicy, nil) //nolint:contextcheck
 	if err != nil {
 		return reconcile.Result{}, err
 	}
@@ -118,7 +261,75 @@ func (r *RootPolicyStatusReconciler) Reconcile(ctx context.Context, request ctrl
 	return reconcile.Result{}, nil
 }
 
-func (r *RootPolicyStatusReconciler) rootStatusUpdate(rootPolicy *policiesv1.Policy) error {
+// scheduleStatusUpdate coalesces reconcile requests for the same root policy that arrive within
+// StatusUpdateBatchWindow of the first one into a single rootStatusUpdate call. The first request in a
+// burst captures the pre-burst status as a baseline, so the eventual update can summarize every
+// compliance transition that happened during the window rather than just the latest one.
+func (r *RootPolicyStatusReconciler) scheduleStatusUpdate(rootPolicy *policiesv1.Policy) {
+	nn := types.NamespacedName{Namespace: rootPolicy.Namespace, Name: rootPolicy.Name}
+
+	baseline := make([]*policiesv1.CompliancePerClusterStatus, len(rootPolicy.Status.Status))
+	copy(baseline, rootPolicy.Status.Status)
+
+	batch := &statusUpdateBatch{baselineCPCS: baseline}
+
+	if _, loaded := r.batches.LoadOrStore(nn, batch); loaded {
+		log.V(2).Info("A status update is already pending for this root policy. Coalescing.",
+			"policyNamespace", nn.Namespace, "policyName", nn.Name)
+
+		return
+	}
+
+	time.AfterFunc(r.StatusUpdateBatchWindow, func() {
+		r.batches.Delete(nn)
+		r.runBatchedStatusUpdate(nn, batch.baselineCPCS)
+	})
+}
+
+// runBatchedStatusUpdate applies a debounced status update once StatusUpdateBatchWindow has
+// elapsed since the first reconcile of the burst.
+func (r *RootPolicyStatusReconciler) runBatchedStatusUpdate(
+	nn types.NamespacedName, baselineCPCS []*policiesv1.CompliancePerClusterStatus,
+) {
+	log := log.WithValues("policyNamespace", nn.Namespace, "policyName", nn.Name)
+
+	lock, _ := r.RootPolicyLocks.LoadOrStore(nn, &sync.Mutex{})
+
+	lock.(*sync.Mutex).Lock()
+	defer lock.(*sync.Mutex).Unlock()
+
+	rootPolicy := &policiesv1.Policy{}
+
+	err := r.Get(context.TODO(), nn, rootPolicy)
+	if err != nil {
+		if !k8serrors.IsNotFound(err) {
+			log.Error(err, "Failed to get the root policy for the batched status update")
+		}
+
+		return
+	}
+
+	log.Info("Applying a batched root policy status update")
+
+	if err := r.rootStatusUpdate(rootPolicy, baselineCPCS); err != nil {
+		log.Error(err, "Failed to apply the batched root policy status update. Requeuing a retry.")
+
+		// rootStatusUpdate failed before persisting anything, but it may already have replaced
+		// rootPolicy's in-memory status with the newly computed one. Restoring baselineCPCS before
+		// scheduling it again preserves the baseline and retries after another
+		// StatusUpdateBatchWindow, instead of silently dropping the update on a transient failure.
+		rootPolicy.Status.Status = baselineCPCS
+		r.scheduleStatusUpdate(rootPolicy)
+	}
+}
+
+// rootStatusUpdate recomputes the root policy's status and writes it back with a single
+// Status().Update call. When baselineCPCS is non-nil (a batched update), it is used as the "before"
+// snapshot for the compliance-transition events instead of the status currently on the cluster, so
+// that the events reflect every transition that occurred since the batch window started.
+func (r *RootPolicyStatusReconciler) rootStatusUpdate(
+	rootPolicy *policiesv1.Policy, baselineCPCS []*policiesv1.CompliancePerClusterStatus,
+) error {
 	placements, decisions, err := r.getDecisions(rootPolicy)
 	if err != nil {
 		log.Info("Failed to get any placement decisions. Giving up on the request.")
@@ -145,6 +356,12 @@ func (r *RootPolicyStatusReconciler) rootStatusUpdate(rootPolicy *policiesv1.Pol
 	originalCPCS := make([]*policiesv1.CompliancePerClusterStatus, len(rootPolicy.Status.Status))
 	copy(originalCPCS, rootPolicy.Status.Status)
 
+	// When this is a batched update, summarize the transitions against the pre-burst snapshot
+	// instead of the possibly-already-updated status just read above.
+	if baselineCPCS != nil {
+		originalCPCS = baselineCPCS
+	}
+
 	rootPolicy.Status.Status = cpcs
 	rootPolicy.Status.ComplianceState = propagator.CalculateRootCompliance(cpcs)
 	rootPolicy.Status.Placement = placements
@@ -154,16 +371,94 @@ func (r *RootPolicyStatusReconciler) rootStatusUpdate(rootPolicy *policiesv1.Pol
 		return err
 	}
 
+	r.emitComplianceTransitionEvents(rootPolicy, originalCPCS, cpcs)
+	r.updateReferencedPolicySets(rootPolicy, placements)
+
 	return nil
 }
 
+// emitComplianceTransitionEvents emits one Event on the root Policy per cluster whose
+// ComplianceState changed between before and after, following the reason/message convention used
+// by the framework-addon's ComplianceEventSender. This makes root-policy history observable via
+// `kubectl describe` and standard event-based alerting pipelines, without watching every
+// replicated policy. It is a no-op if nothing changed, no Recorder is configured, or
+// DisableTransitionEvents is set.
+func (r *RootPolicyStatusReconciler) emitComplianceTransitionEvents(
+	rootPolicy *policiesv1.Policy, before, after []*policiesv1.CompliancePerClusterStatus,
+) {
+	if r.Recorder == nil || r.DisableTransitionEvents {
+		return
+	}
+
+	reason := fmt.Sprintf("policy: %s/%s", rootPolicy.Namespace, rootPolicy.Name)
+
+	for _, transition := range diffComplianceTransitions(before, after) {
+		previous := transition.Previous
+		if previous == "" {
+			previous = "Pending"
+		}
+
+		r.Recorder.Eventf(
+			rootPolicy,
+			corev1.EventTypeNormal,
+			reason,
+			"%s compliance state changed from %s to %s",
+			transition.ClusterName,
+			previous,
+			transition.Current,
+		)
+	}
+}
+
+// complianceTransition describes a single cluster's compliance state change between two
+// reconciles of the root policy.
+type complianceTransition struct {
+	ClusterName string
+	Previous    policiesv1.ComplianceState
+	Current     policiesv1.ComplianceState
+}
+
+// diffComplianceTransitions returns the clusters whose ComplianceState differs between before and
+// after, sorted by cluster name for deterministic event ordering. A cluster that only appears in
+// after (e.g. newly scheduled) is reported with an empty Previous state.
+func diffComplianceTransitions(
+	before, after []*policiesv1.CompliancePerClusterStatus,
+) []complianceTransition {
+	previous := make(map[string]policiesv1.ComplianceState, len(before))
+	for _, cpcs := range before {
+		previous[cpcs.ClusterName] = cpcs.ComplianceState
+	}
+
+	var transitions []complianceTransition
+
+	for _, cpcs := range after {
+		prevState, seen := previous[cpcs.ClusterName]
+		if seen && prevState == cpcs.ComplianceState {
+			continue
+		}
+
+		transitions = append(transitions, complianceTransition{
+			ClusterName: cpcs.ClusterName,
+			Previous:    prevState,
+			Current:     cpcs.ComplianceState,
+		})
+	}
+
+	sort.Slice(transitions, func(i, j int) bool { return transitions[i].ClusterName < transitions[j].ClusterName })
+
+	return transitions
+}
+
 // getPolicyPlacementDecisions retrieves the placement decisions for a input PlacementBinding when
 // the policy is bound within it. It can return an error if the PlacementBinding is invalid, or if
 // a required lookup fails.
 func (r *RootPolicyStatusReconciler) getPolicyPlacementDecisions(
 	instance *policiesv1.Policy, pb *policiesv1.PlacementBinding,
 ) (decisions []appsv1.PlacementDecision, placements []*policiesv1.Placement, err error) {
-	if !common.HasValidPlacementRef(pb) {
+	// Only check that the ref is structurally present here. Which Kind values are acceptable is not
+	// hardcoded: it's determined solely by whether a ClusterDecisionResolver is registered for that
+	// Kind below, so that RegisterResolver can actually add support for a new placement backend.
+	if pb.PlacementRef.Name == "" {
 		return nil, nil, fmt.Errorf("placement binding %s/%s reference is not valid", pb.Name, pb.Namespace)
 	}
 
@@ -203,46 +498,31 @@ func (r *RootPolicyStatusReconciler) getPolicyPlacementDecisions(
 		return nil, nil, nil
 	}
 
-	// If the placementRef exists, then it needs to be added to the placement item
-	refNN := types.NamespacedName{
-		Namespace: pb.GetNamespace(),
-		Name:      pb.PlacementRef.Name,
-	}
+	// Resolve the PlacementRef itself via the registered ClusterDecisionResolver for its Kind. This
+	// is where the per-backend logic (PlacementRule, Placement, or a downstream-registered kind)
+	// lives, so that adding support for another placement backend doesn't require touching this
+	// function.
+	resolver, ok := r.resolverFor(pb.PlacementRef.Kind)
+	if !ok {
+		log.Info("No ClusterDecisionResolver is registered for this PlacementRef kind. Skipping.",
+			"placementRefKind", pb.PlacementRef.Kind, "bindingName", pb.GetName())
 
-	switch pb.PlacementRef.Kind {
-	case "PlacementRule":
-		plr := &appsv1.PlacementRule{}
-		if err := r.Get(context.TODO(), refNN, plr); err != nil && !k8serrors.IsNotFound(err) {
-			return nil, nil, fmt.Errorf("failed to check for PlacementRule '%v': %w", pb.PlacementRef.Name, err)
-		}
-
-		for i := range placements {
-			placements[i].PlacementRule = plr.Name // will be empty if the PlacementRule was not found
-		}
-	case "Placement":
-		pl := &clusterv1beta1.Placement{}
-		if err := r.Get(context.TODO(), refNN, pl); err != nil && !k8serrors.IsNotFound(err) {
-			return nil, nil, fmt.Errorf("failed to check for Placement '%v': %w", pb.PlacementRef.Name, err)
-		}
-
-		for i := range placements {
-			placements[i].Placement = pl.Name // will be empty if the Placement was not found
-		}
+		return nil, placements, nil
 	}
 
-	// If there are no placements, then the PlacementBinding is not for this Policy.
-	if len(placements) == 0 {
-		return nil, nil, nil
+	decisions, refPlacement, err := resolver.Resolve(context.TODO(), pb, instance)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	// If the policy is disabled, don't return any decisions, so that the policy isn't put on any clusters
-	if instance.Spec.Disabled {
-		return nil, placements, nil
+	if refPlacement != nil {
+		for i := range placements {
+			placements[i].PlacementRule = refPlacement.PlacementRule
+			placements[i].Placement = refPlacement.Placement
+		}
 	}
 
-	decisions, err = common.GetDecisions(r.Client, pb)
-
-	return decisions, placements, err
+	return decisions, placements, nil
 }
 
 // getAllClusterDecisions calculates which managed clusters should have a replicated policy, and
@@ -378,6 +658,12 @@ func (r *RootPolicyStatusReconciler) getDecisions(
 	return placements, decisions, nil
 }
 
+// calculatePerClusterStatus fans the replicated policy lookup out over a bounded worker pool
+// (MaxStatusLookupWorkers) since this is an O(N) API round-trip per root-policy reconcile and
+// becomes the dominant cost with a large number of managed clusters. Results are written into a
+// slice preallocated in decision order so that the final sort stays deterministic regardless of
+// which lookup finishes first. A NotFound lookup yields a CPCS entry with an empty
+// ComplianceState; the first non-NotFound error is returned once every lookup has completed.
 func (r *RootPolicyStatusReconciler) calculatePerClusterStatus(
 	instance *policiesv1.Policy, decisions decisionSet,
 ) ([]*policiesv1.CompliancePerClusterStatus, error) {
@@ -385,37 +671,52 @@ func (r *RootPolicyStatusReconciler) calculatePerClusterStatus(
 		return nil, nil
 	}
 
-	status := make([]*policiesv1.CompliancePerClusterStatus, 0, len(decisions))
-	var lookupErr error // save until end, to attempt all lookups
-
-	// Update the status based on the processed decisions
+	decisionList := make([]appsv1.PlacementDecision, 0, len(decisions))
 	for dec := range decisions {
-		replicatedPolicy := &policiesv1.Policy{}
-		key := types.NamespacedName{
-			Namespace: dec.ClusterNamespace, Name: instance.Namespace + "." + instance.Name,
-		}
+		decisionList = append(decisionList, dec)
+	}
 
-		err := r.Get(context.TODO(), key, replicatedPolicy)
-		if err != nil {
-			if k8serrors.IsNotFound(err) {
-				status = append(status, &policiesv1.CompliancePerClusterStatus{
+	workers := r.MaxStatusLookupWorkers
+	if workers <= 0 {
+		workers = defaultMaxStatusLookupWorkers
+	}
+
+	status := make([]*policiesv1.CompliancePerClusterStatus, len(decisionList))
+
+	lookups := new(errgroup.Group)
+	lookups.SetLimit(workers)
+
+	for i, dec := range decisionList {
+		i, dec := i, dec
+
+		lookups.Go(func() error {
+			replicatedPolicy := &policiesv1.Policy{}
+			key := types.NamespacedName{
+				Namespace: dec.ClusterNamespace, Name: instance.Namespace + "." + instance.Name,
+			}
+
+			err := r.Get(context.TODO(), key, replicatedPolicy)
+			if err != nil && !k8serrors.IsNotFound(err) {
+				status[i] = &policiesv1.CompliancePerClusterStatus{
 					ClusterName:      dec.ClusterName,
 					ClusterNamespace: dec.ClusterNamespace,
-				})
+				}
 
-				continue
+				return err
 			}
 
-			lookupErr = err
-		}
+			status[i] = &policiesv1.CompliancePerClusterStatus{
+				ComplianceState:  replicatedPolicy.Status.ComplianceState,
+				ClusterName:      dec.ClusterName,
+				ClusterNamespace: dec.ClusterNamespace,
+			}
 
-		status = append(status, &policiesv1.CompliancePerClusterStatus{
-			ComplianceState:  replicatedPolicy.Status.ComplianceState,
-			ClusterName:      dec.ClusterName,
-			ClusterNamespace: dec.ClusterNamespace,
+			return nil
 		})
 	}
 
+	lookupErr := lookups.Wait()
+
 	sort.Slice(status, func(i, j int) bool {
 		return status[i].ClusterName < status[j].ClusterName
 	})
@@ -446,3 +747,68 @@ func (r *RootPolicyStatusReconciler) isPolicyInPolicySet(policyName, policySetNa
 
 	return false
 }
+
+// detectDrift lists all root policies and recomputes their status, sending a GenericEvent for any
+// policy whose persisted Status.Status has drifted from what is currently computed. This is a
+// best-effort background pass: a lookup failure for one policy is logged and skipped rather than
+// aborting the whole scan.
+func (r *RootPolicyStatusReconciler) detectDrift(ctx context.Context, driftEvents chan<- event.GenericEvent) {
+	log.V(2).Info("Starting a drift-detection scan of all root policies")
+
+	requirement, err := labels.NewRequirement(rootPolicyLabel, selection.DoesNotExist, nil)
+	if err != nil {
+		log.Error(err, "Failed to build the drift-detection label selector")
+
+		return
+	}
+
+	policyList := &policiesv1.PolicyList{}
+
+	err = r.List(ctx, policyList, &client.ListOptions{LabelSelector: labels.NewSelector().Add(*requirement)})
+	if err != nil {
+		log.Error(err, "Failed to list the root policies for drift detection")
+
+		return
+	}
+
+	for i := range policyList.Items {
+		policy := &policyList.Items[i]
+		pLog := log.WithValues("policyNamespace", policy.Namespace, "policyName", policy.Name)
+
+		_, decisions, err := r.getDecisions(policy)
+		if err != nil {
+			pLog.Error(err, "Failed to get the placement decisions during drift detection. Skipping.")
+
+			continue
+		}
+
+		cpcs, err := r.calculatePerClusterStatus(policy, decisions)
+		if err != nil {
+			// cpcs is only partially populated when a lookup fails, so comparing it against the
+			// persisted status would almost always report drift and enqueue a reconcile every scan.
+			// Skip this policy and let the next scan retry once the replicated lookups succeed.
+			pLog.V(2).Info("Failed to get at least one replicated policy during drift detection. Skipping this scan.")
+
+			continue
+		}
+
+		// A policy with no placement decisions round-trips its persisted Status.Status as nil
+		// (omitempty), while calculatePerClusterStatus returns a non-nil empty slice. Treat both as
+		// equal so that case doesn't "drift" on every scan.
+		if len(policy.Status.Status) == 0 && len(cpcs) == 0 {
+			continue
+		}
+
+		if equality.Semantic.DeepEqual(policy.Status.Status, cpcs) {
+			continue
+		}
+
+		pLog.Info("Detected root policy status drift. Enqueuing a reconcile.")
+
+		select {
+		case driftEvents <- event.GenericEvent{Object: policy}:
+		case <-ctx.Done():
+			return
+		}
+	}
+}